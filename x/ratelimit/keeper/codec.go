@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	"github.com/Stride-Labs/stride/v9/x/ratelimit/types"
+)
+
+// mustMarshalRateLimit encodes a rate limit for storage
+//
+// NOTE: RateLimit is a plain (non-proto) struct in this module, so it's
+// encoded as JSON rather than via the binary codec
+func (k Keeper) mustMarshalRateLimit(rateLimit types.RateLimit) []byte {
+	bz, err := json.Marshal(rateLimit)
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+func (k Keeper) mustUnmarshalRateLimit(bz []byte, rateLimit *types.RateLimit) {
+	if err := json.Unmarshal(bz, rateLimit); err != nil {
+		panic(err)
+	}
+}
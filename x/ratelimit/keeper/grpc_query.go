@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v9/x/ratelimit/types"
+)
+
+// QueryRateLimitRequest identifies a rate limit by denom and channel-id. It is a plain Go
+// struct served by Keeper.RateLimit; it is not registered as a proto query type nor added to
+// any stargate query whitelist, so CosmWasm contracts cannot reach it through a stargate query
+type QueryRateLimitRequest struct {
+	Denom     string
+	ChannelId string
+}
+
+// QueryRateLimitResponse wraps the rate limit, if found
+type QueryRateLimitResponse struct {
+	RateLimit types.RateLimit
+}
+
+// RateLimit implements the query service for fetching a single rate limit's current
+// path, quota and flow state
+func (k Keeper) RateLimit(goCtx context.Context, req *QueryRateLimitRequest) (*QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rateLimit, found := k.GetRateLimit(ctx, req.Denom, req.ChannelId)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrRateLimitNotFound, "denom %s, channel %s", req.Denom, req.ChannelId)
+	}
+
+	return &QueryRateLimitResponse{RateLimit: rateLimit}, nil
+}
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	sdkerrors "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	"github.com/Stride-Labs/stride/v9/x/ratelimit/types"
+)
+
+// QuerySenderAllowanceRequest identifies a sender allowance by sender, channel-id and denom
+type QuerySenderAllowanceRequest struct {
+	Sender    string
+	ChannelId string
+	Denom     string
+}
+
+// QuerySenderAllowanceResponse wraps the sender allowance, if found
+type QuerySenderAllowanceResponse struct {
+	Allowance types.SenderAllowance
+}
+
+// SenderAllowance implements the query service for fetching a single sender allowance
+func (k Keeper) SenderAllowance(goCtx context.Context, req *QuerySenderAllowanceRequest) (*QuerySenderAllowanceResponse, error) {
+	if req == nil {
+		return nil, grpcstatus.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	allowance, found := k.GetSenderAllowance(ctx, req.Sender, req.ChannelId, req.Denom)
+	if !found {
+		return nil, sdkerrors.Wrapf(types.ErrSenderAllowanceNotFound, "sender %s, channel %s, denom %s", req.Sender, req.ChannelId, req.Denom)
+	}
+
+	return &QuerySenderAllowanceResponse{Allowance: allowance}, nil
+}
@@ -0,0 +1,27 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+)
+
+func marshalTransferPacket(sender, receiver, denom, amount string) ([]byte, error) {
+	return json.Marshal(transfertypes.FungibleTokenPacketData{
+		Denom:    denom,
+		Amount:   amount,
+		Sender:   sender,
+		Receiver: receiver,
+	})
+}
+
+func makeSendPacket(sourceChannel, destinationChannel string, data []byte) channeltypes.Packet {
+	return channeltypes.Packet{
+		SourcePort:         transferPort,
+		SourceChannel:      sourceChannel,
+		DestinationPort:    transferPort,
+		DestinationChannel: destinationChannel,
+		Data:               data,
+	}
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/x/ratelimit/types"
+)
+
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeKey      storetypes.StoreKey
+	memKey        storetypes.StoreKey
+	authority     string
+	ChannelKeeper types.ChannelKeeper
+	WasmKeeper    types.WasmKeeper
+}
+
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeKey,
+	memKey storetypes.StoreKey,
+	authority string,
+	channelKeeper types.ChannelKeeper,
+	wasmKeeper types.WasmKeeper,
+) Keeper {
+	return Keeper{
+		cdc:           cdc,
+		storeKey:      storeKey,
+		memKey:        memKey,
+		authority:     authority,
+		ChannelKeeper: channelKeeper,
+		WasmKeeper:    wasmKeeper,
+	}
+}
+
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
+
+// GetAuthority returns the module's x/gov authority address, used to gate
+// privileged messages such as granting or revoking a sender allowance
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// GetRateLimit fetches a rate limit given its denom and channel-id
+func (k Keeper) GetRateLimit(ctx sdk.Context, denom string, channelId string) (types.RateLimit, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetRateLimitItemKey(denom, channelId)
+
+	bz := store.Get(append(types.RateLimitKeyPrefix, key...))
+	if bz == nil {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.mustUnmarshalRateLimit(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// SetRateLimit stores a rate limit, keyed by its denom and channel-id
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetRateLimitItemKey(rateLimit.Path.Denom, rateLimit.Path.ChannelId)
+	store.Set(append(types.RateLimitKeyPrefix, key...), k.mustMarshalRateLimit(rateLimit))
+}
+
+// RemoveRateLimit deletes a rate limit given its denom and channel-id
+func (k Keeper) RemoveRateLimit(ctx sdk.Context, denom string, channelId string) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetRateLimitItemKey(denom, channelId)
+	store.Delete(append(types.RateLimitKeyPrefix, key...))
+}
+
+// ResetRateLimitFlow zeroes out a rate limit's inflow and outflow, as happens when its
+// quota window rolls over, and notifies the hook contract (if configured) via
+// SendEpochResetHook. Scheduling - i.e. calling this once every Quota.DurationHours - is
+// left to whatever periodic routine the app wires up (e.g. an epochs module hook); this
+// module only owns the reset operation itself, not the clock that triggers it
+func (k Keeper) ResetRateLimitFlow(ctx sdk.Context, denom, channelId string) error {
+	rateLimit, found := k.GetRateLimit(ctx, denom, channelId)
+	if !found {
+		return types.ErrRateLimitNotFound.Wrapf("denom %s, channel %s", denom, channelId)
+	}
+
+	rateLimit.Flow.Inflow = sdkmath.ZeroInt()
+	rateLimit.Flow.Outflow = sdkmath.ZeroInt()
+	k.SetRateLimit(ctx, rateLimit)
+
+	k.SendEpochResetHook(ctx, denom, channelId)
+	return nil
+}
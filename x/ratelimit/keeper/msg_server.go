@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/x/ratelimit/types"
+)
+
+type msgServer struct {
+	Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface for the
+// provided keeper
+func NewMsgServerImpl(keeper Keeper) msgServer {
+	return msgServer{Keeper: keeper}
+}
+
+// GrantSenderAllowance grants (or replaces) a sender allowance
+func (k msgServer) GrantSenderAllowance(goCtx context.Context, msg *types.MsgGrantSenderAllowance) (*types.MsgGrantSenderAllowanceResponse, error) {
+	if k.Keeper.GetAuthority() != msg.Authority {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", k.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	k.Keeper.SetSenderAllowance(ctx, types.SenderAllowance{
+		Sender:     msg.Sender,
+		ChannelId:  msg.ChannelId,
+		Denom:      msg.Denom,
+		SpendLimit: msg.SpendLimit,
+		AllowList:  msg.AllowList,
+	})
+
+	return &types.MsgGrantSenderAllowanceResponse{}, nil
+}
+
+// RevokeSenderAllowance removes a sender allowance
+func (k msgServer) RevokeSenderAllowance(goCtx context.Context, msg *types.MsgRevokeSenderAllowance) (*types.MsgRevokeSenderAllowanceResponse, error) {
+	if k.Keeper.GetAuthority() != msg.Authority {
+		return nil, types.ErrInvalidAuthority.Wrapf("expected %s, got %s", k.Keeper.GetAuthority(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.Keeper.GetSenderAllowance(ctx, msg.Sender, msg.ChannelId, msg.Denom); !found {
+		return nil, types.ErrSenderAllowanceNotFound.Wrapf("no allowance for sender %s on channel %s for denom %s", msg.Sender, msg.ChannelId, msg.Denom)
+	}
+
+	k.Keeper.RemoveSenderAllowance(ctx, msg.Sender, msg.ChannelId, msg.Denom)
+
+	return &types.MsgRevokeSenderAllowanceResponse{}, nil
+}
@@ -0,0 +1,39 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Stride-Labs/stride/v9/x/ratelimit/keeper"
+	"github.com/Stride-Labs/stride/v9/x/ratelimit/types"
+)
+
+func (s *KeeperTestSuite) TestMsgServer_GrantAndRevokeSenderAllowance() {
+	msgServer := keeper.NewMsgServerImpl(s.App.RatelimitKeeper)
+
+	authority := s.App.RatelimitKeeper.GetAuthority()
+	sender := "stride1sender..."
+
+	grantMsg := types.NewMsgGrantSenderAllowance(authority, sender, channelOnStride, ustrd, sdkmath.NewInt(100), []string{"osmo1receiver..."})
+	_, err := msgServer.GrantSenderAllowance(s.Ctx, grantMsg)
+	s.Require().NoError(err)
+
+	allowance, found := s.App.RatelimitKeeper.GetSenderAllowance(s.Ctx, sender, channelOnStride, ustrd)
+	s.Require().True(found)
+	s.Require().Equal(sdkmath.NewInt(100), allowance.SpendLimit)
+
+	revokeMsg := types.NewMsgRevokeSenderAllowance(authority, sender, channelOnStride, ustrd)
+	_, err = msgServer.RevokeSenderAllowance(s.Ctx, revokeMsg)
+	s.Require().NoError(err)
+
+	_, found = s.App.RatelimitKeeper.GetSenderAllowance(s.Ctx, sender, channelOnStride, ustrd)
+	s.Require().False(found)
+}
+
+func (s *KeeperTestSuite) TestMsgServer_RevokeSenderAllowance_NotFound() {
+	msgServer := keeper.NewMsgServerImpl(s.App.RatelimitKeeper)
+	authority := s.App.RatelimitKeeper.GetAuthority()
+
+	revokeMsg := types.NewMsgRevokeSenderAllowance(authority, "stride1nonexistent...", channelOnStride, ustrd)
+	_, err := msgServer.RevokeSenderAllowance(s.Ctx, revokeMsg)
+	s.Require().ErrorIs(err, types.ErrSenderAllowanceNotFound)
+}
@@ -0,0 +1,311 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/stride/v9/x/ratelimit/types"
+)
+
+// parseSendDenom determines the denom, as it will appear on this chain, of a raw denom
+// trace string (e.g. "transfer/channel-0/uosmo") carried by an outgoing transfer packet
+func parseSendDenom(rawDenom string) string {
+	denomTrace := transfertypes.ParseDenomTrace(rawDenom)
+	return denomTrace.IBCDenom()
+}
+
+// parseRecvDenom determines the denom, as it will appear on this chain, of a raw denom
+// trace string carried by an incoming transfer packet
+func parseRecvDenom(packet channeltypes.Packet, rawDenom string) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), rawDenom) {
+		// Remove the prefix tacked on by the sender chain since we're the source
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedDenom := rawDenom[len(voucherPrefix):]
+		return transfertypes.ParseDenomTrace(unprefixedDenom).IBCDenom()
+	}
+
+	// Otherwise, we're receiving a sink asset, so tack on our own prefix
+	prefixedDenom := transfertypes.GetDenomPrefix(packet.GetDestinationPort(), packet.GetDestinationChannel()) + rawDenom
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
+
+// ParseDenomFromSendPacket determines the denom, as it will appear on this chain, of a
+// v1 (single-coin) outgoing transfer packet
+func ParseDenomFromSendPacket(packet transfertypes.FungibleTokenPacketData) string {
+	return parseSendDenom(packet.Denom)
+}
+
+// ParseDenomFromSendPacketV2 determines the denom, as it will appear on this chain, of a
+// single token within a v2 (multi-coin) outgoing transfer packet, by joining the token's
+// trace hops with its base denom and applying the same logic as the v1 parser
+func ParseDenomFromSendPacketV2(token types.Token) string {
+	return parseSendDenom(token.Denom.TracePath())
+}
+
+// ParseDenomFromRecvPacket determines the denom, as it will appear on this chain, of a
+// v1 (single-coin) incoming transfer packet
+func ParseDenomFromRecvPacket(packet channeltypes.Packet, packetData transfertypes.FungibleTokenPacketData) string {
+	return parseRecvDenom(packet, packetData.Denom)
+}
+
+// ParseDenomFromRecvPacketV2 determines the denom, as it will appear on this chain, of a
+// single token within a v2 (multi-coin) incoming transfer packet
+func ParseDenomFromRecvPacketV2(packet channeltypes.Packet, token types.Token) string {
+	return parseRecvDenom(packet, token.Denom.TracePath())
+}
+
+// isPacketDataV2 distinguishes a v2 (multi-coin, "tokens") packet from a v1
+// (single-coin, "denom"/"amount") packet without assuming either shape up front
+func isPacketDataV2(bz []byte) bool {
+	var probe struct {
+		Tokens []types.Token `json:"tokens"`
+	}
+	if err := json.Unmarshal(bz, &probe); err != nil {
+		return false
+	}
+	return len(probe.Tokens) > 0
+}
+
+// evaluateRateLimit looks up the rate limit for (denom, channelId) and, if found, checks
+// whether amount would breach its quota in the given direction. It does NOT write the
+// updated flow to the store - it returns the updated copy for the caller to persist once
+// every token in the packet is known to pass, so that one token breaching quota can't
+// leave an earlier token's flow update in the same packet persisted
+func (k Keeper) evaluateRateLimit(ctx sdk.Context, direction types.PacketDirection, denom, channelId string, amount sdkmath.Int) (*types.RateLimit, error) {
+	rateLimit, found := k.GetRateLimit(ctx, denom, channelId)
+	if !found {
+		// No rate limit configured for this denom/channel pair - nothing to enforce
+		return nil, nil
+	}
+
+	if err := rateLimit.CheckExceedsQuota(direction, amount); err != nil {
+		k.SendRateLimitExceededHook(ctx, direction, rateLimit, amount)
+		return nil, err
+	}
+
+	rateLimit.UpdateFlow(direction, amount)
+	return &rateLimit, nil
+}
+
+// classifyRecvLeg determines which channel-id bucket this leg's quota should accumulate
+// against: the native (settled) bucket for channelId, or - when the inbound memo carries a
+// PFM forward instruction - its dedicated transit bucket. A forward is also recorded via
+// markPendingForward so the outgoing send leg of the same relay, whose own memo no longer
+// carries the instruction that caused this hop, can recognize it as transit too. track is
+// false when this leg is transit volume that TrackTransitVolume has opted out of tracking
+// altogether, meaning there's no quota to accumulate against at all
+func (k Keeper) classifyRecvLeg(ctx sdk.Context, channelId, memo, denom string, amount sdkmath.Int) (bucketChannelId string, track bool) {
+	forward, isForwarded := types.ParsePacketForwardMemo(memo)
+	if !isForwarded {
+		return channelId, true
+	}
+
+	k.markPendingForward(ctx, denom, amount, forward.Port, forward.Channel, forward.Receiver)
+	if !k.GetParams(ctx).TrackTransitVolume {
+		return "", false
+	}
+	return types.TransitChannelId(channelId), true
+}
+
+// classifySendLeg is classifyRecvLeg for the outgoing leg of a packet. Unlike on receive,
+// the memo this leg is about to send carries the *next* hop's instructions (if any), not
+// the one that caused this hop - so a multi-hop forward is still detected directly from the
+// outgoing memo, but a last-hop forward (where the outgoing memo is empty or unrelated) is
+// instead recognized via consumePendingForward, which was populated by the matching receive
+// leg earlier in the same relay
+func (k Keeper) classifySendLeg(ctx sdk.Context, channelId, memo, denom string, amount sdkmath.Int, sendPort, sendChannel, receiver string) (bucketChannelId string, track bool) {
+	_, isForwarded := types.ParsePacketForwardMemo(memo)
+	if !isForwarded {
+		isForwarded = k.consumePendingForward(ctx, denom, amount, sendPort, sendChannel, receiver)
+	}
+	if !isForwarded {
+		return channelId, true
+	}
+
+	if !k.GetParams(ctx).TrackTransitVolume {
+		return "", false
+	}
+	return types.TransitChannelId(channelId), true
+}
+
+// quotaKey identifies the rate limit that a token's amount should accumulate against,
+// before being checked and persisted once per key for the packet as a whole
+type quotaKey struct {
+	denom     string
+	channelId string
+}
+
+// quotaAccumulator sums token amounts per quotaKey, preserving first-seen key order so
+// that iterating the totals afterward is deterministic rather than at the mercy of Go's
+// randomized map iteration. Accumulating before evaluating means two tokens in the same
+// packet that individually sit under a rate limit's remaining headroom, but together
+// would breach it, are correctly treated as one combined amount against that rate limit
+type quotaAccumulator struct {
+	keys    []quotaKey
+	amounts map[quotaKey]sdkmath.Int
+}
+
+func newQuotaAccumulator() *quotaAccumulator {
+	return &quotaAccumulator{amounts: map[quotaKey]sdkmath.Int{}}
+}
+
+func (a *quotaAccumulator) add(key quotaKey, amount sdkmath.Int) {
+	if existing, ok := a.amounts[key]; ok {
+		a.amounts[key] = existing.Add(amount)
+		return
+	}
+	a.keys = append(a.keys, key)
+	a.amounts[key] = amount
+}
+
+// allowanceKey identifies the sender allowance that a token's amount should accumulate
+// against, before being checked and persisted once per key for the packet as a whole
+type allowanceKey struct {
+	sender    string
+	channelId string
+	denom     string
+}
+
+// allowanceAccumulator is quotaAccumulator for sender allowances
+type allowanceAccumulator struct {
+	keys    []allowanceKey
+	amounts map[allowanceKey]sdkmath.Int
+}
+
+func newAllowanceAccumulator() *allowanceAccumulator {
+	return &allowanceAccumulator{amounts: map[allowanceKey]sdkmath.Int{}}
+}
+
+func (a *allowanceAccumulator) add(key allowanceKey, amount sdkmath.Int) {
+	if existing, ok := a.amounts[key]; ok {
+		a.amounts[key] = existing.Add(amount)
+		return
+	}
+	a.keys = append(a.keys, key)
+	a.amounts[key] = amount
+}
+
+// SendRateLimitedPacket checks the rate limit(s) and sender allowance implicated by an
+// outgoing IBC transfer packet, and only once every token in the packet has passed does it
+// persist any of their flow/allowance updates. The packet data is resolved via
+// UnmarshalPacketData, so this works uniformly whether the channel negotiated ics20-1
+// (single-coin) or ics20-2 (multi-coin). The whole packet is rejected - with none of its
+// tokens' updates persisted - if any single token would breach its channel-wide quota,
+// exhaust its sender's allowance, or send to a receiver the sender's allowance doesn't list
+func (k Keeper) SendRateLimitedPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	channelId := packet.GetSourceChannel()
+
+	packetData, _, err := k.UnmarshalPacketData(ctx, packet.GetSourcePort(), channelId, packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	rawDenoms := packetData.GetDenoms()
+	amounts, err := packetData.GetAmounts()
+	if err != nil {
+		return err
+	}
+
+	sender := packetData.GetSender()
+	receiver := packetData.GetReceiver()
+	memo := packetData.GetMemo()
+
+	quotas := newQuotaAccumulator()
+	allowances := newAllowanceAccumulator()
+
+	for i, rawDenom := range rawDenoms {
+		denom := parseSendDenom(rawDenom)
+		amount := amounts[i]
+
+		if bucketChannelId, track := k.classifySendLeg(ctx, channelId, memo, denom, amount, packet.GetSourcePort(), channelId, receiver); track {
+			quotas.add(quotaKey{denom: denom, channelId: bucketChannelId}, amount)
+		}
+
+		allowances.add(allowanceKey{sender: sender, channelId: channelId, denom: denom}, amount)
+	}
+
+	var rateLimitUpdates []types.RateLimit
+	for _, key := range quotas.keys {
+		rateLimit, err := k.evaluateRateLimit(ctx, types.PACKET_SEND, key.denom, key.channelId, quotas.amounts[key])
+		if err != nil {
+			return err
+		}
+		if rateLimit != nil {
+			rateLimitUpdates = append(rateLimitUpdates, *rateLimit)
+		}
+	}
+
+	var allowanceUpdates []types.SenderAllowance
+	for _, key := range allowances.keys {
+		allowance, err := k.evaluateSenderAllowance(ctx, key.sender, key.channelId, key.denom, receiver, allowances.amounts[key])
+		if err != nil {
+			return err
+		}
+		if allowance != nil {
+			allowanceUpdates = append(allowanceUpdates, *allowance)
+		}
+	}
+
+	for _, rateLimit := range rateLimitUpdates {
+		k.SetRateLimit(ctx, rateLimit)
+	}
+	for _, allowance := range allowanceUpdates {
+		k.SetSenderAllowance(ctx, allowance)
+	}
+
+	return nil
+}
+
+// ReceiveRateLimitedPacket checks the rate limit(s) implicated by an incoming IBC transfer
+// packet, and only once every token in the packet has passed does it persist any of their
+// flow updates. The packet data is resolved via UnmarshalPacketData, so this works
+// uniformly whether the channel negotiated ics20-1 (single-coin) or ics20-2 (multi-coin).
+// The whole packet is rejected - with none of its tokens' flow updates persisted - if any
+// single token would breach quota
+func (k Keeper) ReceiveRateLimitedPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	channelId := packet.GetDestinationChannel()
+
+	packetData, _, err := k.UnmarshalPacketData(ctx, packet.GetDestinationPort(), channelId, packet.GetData())
+	if err != nil {
+		return err
+	}
+
+	rawDenoms := packetData.GetDenoms()
+	amounts, err := packetData.GetAmounts()
+	if err != nil {
+		return err
+	}
+
+	memo := packetData.GetMemo()
+	quotas := newQuotaAccumulator()
+
+	for i, rawDenom := range rawDenoms {
+		denom := parseRecvDenom(packet, rawDenom)
+		amount := amounts[i]
+
+		if bucketChannelId, track := k.classifyRecvLeg(ctx, channelId, memo, denom, amount); track {
+			quotas.add(quotaKey{denom: denom, channelId: bucketChannelId}, amount)
+		}
+	}
+
+	var rateLimitUpdates []types.RateLimit
+	for _, key := range quotas.keys {
+		rateLimit, err := k.evaluateRateLimit(ctx, types.PACKET_RECV, key.denom, key.channelId, quotas.amounts[key])
+		if err != nil {
+			return err
+		}
+		if rateLimit != nil {
+			rateLimitUpdates = append(rateLimitUpdates, *rateLimit)
+		}
+	}
+
+	for _, rateLimit := range rateLimitUpdates {
+		k.SetRateLimit(ctx, rateLimit)
+	}
+
+	return nil
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+
+	"github.com/Stride-Labs/stride/v9/x/ratelimit/types"
+)
+
+// UnmarshalPacketData resolves a transfer packet's data into a version-agnostic
+// types.PacketData, along with the channel's negotiated app version. It looks up the
+// channel's version from the channel keeper to decide which proto shape to unmarshal into
+// (ics20-1's single-coin FungibleTokenPacketData vs ics20-2's multi-coin
+// FungibleTokenPacketDataV2), falling back to sniffing the JSON shape if the channel (or
+// its version) can't be found - e.g. in tests that construct a packet without a channel
+func (k Keeper) UnmarshalPacketData(ctx sdk.Context, portID, channelID string, bz []byte) (types.PacketData, string, error) {
+	version := k.channelVersion(ctx, portID, channelID, bz)
+
+	switch version {
+	case types.ICS20V2:
+		var packetDataV2 types.FungibleTokenPacketDataV2
+		if err := json.Unmarshal(bz, &packetDataV2); err != nil {
+			return nil, version, types.ErrInvalidPacketData.Wrap(err.Error())
+		}
+		return types.NewPacketDataV2(packetDataV2), version, nil
+	default:
+		var packetData transfertypes.FungibleTokenPacketData
+		if err := json.Unmarshal(bz, &packetData); err != nil {
+			return nil, version, types.ErrInvalidPacketData.Wrap(err.Error())
+		}
+		return types.NewPacketDataV1(packetData.Denom, packetData.Amount, packetData.Sender, packetData.Receiver, packetData.Memo), version, nil
+	}
+}
+
+// channelVersion determines the packet-data version to dispatch on: the channel's
+// negotiated app version if the channel can be found, otherwise a best-effort sniff of
+// the packet data's JSON shape
+func (k Keeper) channelVersion(ctx sdk.Context, portID, channelID string, bz []byte) string {
+	if k.ChannelKeeper != nil {
+		if channel, found := k.ChannelKeeper.GetChannel(ctx, portID, channelID); found && channel.Version != "" {
+			return channel.Version
+		}
+	}
+
+	if isPacketDataV2(bz) {
+		return types.ICS20V2
+	}
+	return types.ICS20V1
+}
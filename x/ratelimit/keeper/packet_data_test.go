@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/x/ratelimit/types"
+)
+
+type mockChannelKeeper struct {
+	channels map[string]channeltypes.Channel
+}
+
+func newMockChannelKeeper() *mockChannelKeeper {
+	return &mockChannelKeeper{channels: map[string]channeltypes.Channel{}}
+}
+
+func (m *mockChannelKeeper) setChannelVersion(portID, channelID, version string) {
+	m.channels[portID+"/"+channelID] = channeltypes.Channel{Version: version}
+}
+
+func (m *mockChannelKeeper) GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool) {
+	channel, found := m.channels[portID+"/"+channelID]
+	return channel, found
+}
+
+func (s *KeeperTestSuite) TestUnmarshalPacketData_ByChannelVersion() {
+	mockChannels := newMockChannelKeeper()
+	mockChannels.setChannelVersion(transferPort, channelOnStride, types.ICS20V2)
+
+	k := s.App.RatelimitKeeper
+	k.ChannelKeeper = mockChannels
+
+	packetDataV2 := types.FungibleTokenPacketDataV2{
+		Tokens: []types.Token{{Denom: types.Denom{Base: ustrd}, Amount: "1"}},
+		Sender: "stride1sender...",
+	}
+	bz, err := json.Marshal(packetDataV2)
+	s.Require().NoError(err)
+
+	packetData, version, err := k.UnmarshalPacketData(s.Ctx, transferPort, channelOnStride, bz)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ICS20V2, version)
+	s.Require().Equal([]string{ustrd}, packetData.GetDenoms())
+}
+
+func (s *KeeperTestSuite) TestUnmarshalPacketData_FallsBackToSniffing() {
+	k := s.App.RatelimitKeeper
+	k.ChannelKeeper = newMockChannelKeeper() // no channel registered
+
+	bz, err := marshalTransferPacket("stride1sender...", "osmo1receiver...", ustrd, "1")
+	s.Require().NoError(err)
+
+	packetData, version, err := k.UnmarshalPacketData(s.Ctx, transferPort, channelOnStride, bz)
+	s.Require().NoError(err)
+	s.Require().Equal(types.ICS20V1, version)
+	s.Require().Equal([]string{ustrd}, packetData.GetDenoms())
+}
@@ -148,6 +148,77 @@ func TestParseDenomFromRecvPacket(t *testing.T) {
 	}
 }
 
+func TestParseDenomFromSendPacketV2(t *testing.T) {
+	testCases := []struct {
+		name          string
+		token         types.Token
+		expectedDenom string
+	}{
+		// Native assets stay as is
+		{
+			name:          "ustrd",
+			token:         types.Token{Denom: types.Denom{Base: ustrd}},
+			expectedDenom: ustrd,
+		},
+		// Non-native assets are hashed, with the trace hops joined back together
+		{
+			name:          "uosmo_one_hop",
+			token:         types.Token{Denom: types.Denom{Base: "usomo", Trace: []string{"transfer/channel-0"}}},
+			expectedDenom: hashDenomTrace("transfer/channel-0/usomo"),
+		},
+		{
+			name:          "uosmo_two_hops",
+			token:         types.Token{Denom: types.Denom{Base: "usomo", Trace: []string{"transfer/channel-2", "transfer/channel-1"}}},
+			expectedDenom: hashDenomTrace("transfer/channel-2/transfer/channel-1/usomo"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			parsedDenom := keeper.ParseDenomFromSendPacketV2(tc.token)
+			require.Equal(t, tc.expectedDenom, parsedDenom, tc.name)
+		})
+	}
+}
+
+func TestParseDenomFromRecvPacketV2(t *testing.T) {
+	osmoChannelOnStride := "channel-0"
+	strideChannelOnOsmo := "channel-100"
+
+	packet := channeltypes.Packet{
+		SourcePort:         transferPort,
+		DestinationPort:    transferPort,
+		SourceChannel:      strideChannelOnOsmo,
+		DestinationChannel: osmoChannelOnStride,
+	}
+
+	testCases := []struct {
+		name          string
+		token         types.Token
+		expectedDenom string
+	}{
+		// Sink asset one hop away -> tack on prefix and hash
+		{
+			name:          "sink_one_hop",
+			token:         types.Token{Denom: types.Denom{Base: uosmo}},
+			expectedDenom: hashDenomTrace(fmt.Sprintf("%s/%s/%s", transferPort, osmoChannelOnStride, uosmo)),
+		},
+		// Native source asset -> remove prefix and leave as is
+		{
+			name:          "native_source",
+			token:         types.Token{Denom: types.Denom{Base: ustrd, Trace: []string{fmt.Sprintf("%s/%s", transferPort, strideChannelOnOsmo)}}},
+			expectedDenom: ustrd,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			parsedDenom := keeper.ParseDenomFromRecvPacketV2(packet, tc.token)
+			require.Equal(t, tc.expectedDenom, parsedDenom, tc.name)
+		})
+	}
+}
+
 func (s *KeeperTestSuite) createRateLimitCloseToQuota(denom string, channelId string, direction types.PacketDirection) {
 	channelValue := sdkmath.NewInt(100)
 	threshold := sdkmath.NewInt(10)
@@ -238,3 +309,170 @@ func (s *KeeperTestSuite) TestReceiveRateLimitedPacket() {
 	s.Require().ErrorIs(err, types.ErrQuotaExceeded, "error type")
 	s.Require().ErrorContains(err, "Inflow exceeds quota", "error text")
 }
+
+func (s *KeeperTestSuite) TestSendRateLimitedPacketV2() {
+	// For send packets, the source will be stride and the destination will be the host
+	sourceChannel := channelOnStride
+	destinationChannel := channelOnHost
+
+	// ustrd stays within quota (9 + 1 = 10), ujuno (non-native, already denom-traced) breaches it (9 + 5 = 14)
+	s.createRateLimitCloseToQuota(ustrd, sourceChannel, types.PACKET_SEND)
+	breachedDenom := hashDenomTrace(fmt.Sprintf("%s/%s/%s", transferPort, sourceChannel, ujuno))
+	s.createRateLimitCloseToQuota(breachedDenom, sourceChannel, types.PACKET_SEND)
+
+	packetDataV2 := types.FungibleTokenPacketDataV2{
+		Tokens: []types.Token{
+			{Denom: types.Denom{Base: ustrd}, Amount: "1"},
+			{Denom: types.Denom{Base: ujuno, Trace: []string{fmt.Sprintf("%s/%s", transferPort, sourceChannel)}}, Amount: "5"},
+		},
+	}
+	packetData, err := json.Marshal(packetDataV2)
+	s.Require().NoError(err)
+
+	packet := channeltypes.Packet{
+		SourcePort:         transferPort,
+		SourceChannel:      sourceChannel,
+		DestinationPort:    transferPort,
+		DestinationChannel: destinationChannel,
+		Data:               packetData,
+	}
+
+	// A single over-quota token in the packet should fail the whole packet
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, packet)
+	s.Require().ErrorIs(err, types.ErrQuotaExceeded, "error type")
+	s.Require().ErrorContains(err, "Outflow exceeds quota", "error text")
+
+	// The ustrd token, which was processed first and didn't breach quota, should not
+	// have had its flow updated since the packet as a whole was rejected
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, ustrd, sourceChannel)
+	s.Require().True(found)
+	s.Require().True(rateLimit.Flow.Outflow.Equal(sdkmath.NewInt(9)), "ustrd outflow should not have been updated")
+}
+
+func (s *KeeperTestSuite) TestReceiveRateLimitedPacketV2() {
+	// For receive packets, the source will be the host and the destination will be stride
+	sourceChannel := channelOnHost
+	destinationChannel := channelOnStride
+
+	ustrdRateLimitDenom := ustrd
+	junoRateLimitDenom := hashDenomTrace(fmt.Sprintf("%s/%s/%s", transferPort, destinationChannel, ujuno))
+
+	s.createRateLimitCloseToQuota(ustrdRateLimitDenom, destinationChannel, types.PACKET_RECV)
+	s.createRateLimitCloseToQuota(junoRateLimitDenom, destinationChannel, types.PACKET_RECV)
+
+	packetDataV2 := types.FungibleTokenPacketDataV2{
+		Tokens: []types.Token{
+			{Denom: types.Denom{Base: ujuno}, Amount: "5"},
+			{Denom: types.Denom{Base: ustrd, Trace: []string{fmt.Sprintf("%s/%s", transferPort, destinationChannel)}}, Amount: "1"},
+		},
+	}
+	packetData, err := json.Marshal(packetDataV2)
+	s.Require().NoError(err)
+
+	packet := channeltypes.Packet{
+		SourcePort:         transferPort,
+		SourceChannel:      sourceChannel,
+		DestinationPort:    transferPort,
+		DestinationChannel: destinationChannel,
+		Data:               packetData,
+	}
+
+	err = s.App.RatelimitKeeper.ReceiveRateLimitedPacket(s.Ctx, packet)
+	s.Require().ErrorIs(err, types.ErrQuotaExceeded, "error type")
+	s.Require().ErrorContains(err, "Inflow exceeds quota", "error text")
+}
+
+// TestSendRateLimitedPacketV2_AccumulatesSameDenom confirms that multiple tokens in the
+// same v2 packet that share a (denom, channelId) are summed before being checked against
+// quota, rather than each being checked independently against the same unmodified stored
+// flow - which would let a transfer split across same-denom tokens evade a quota that
+// neither token would breach on its own
+func (s *KeeperTestSuite) TestSendRateLimitedPacketV2_AccumulatesSameDenom() {
+	sourceChannel := channelOnStride
+	destinationChannel := channelOnHost
+
+	// Outflow is already at 9/10 of quota. Neither token below would individually push it
+	// over, but their sum (9 + 1 + 1 = 11) would
+	s.createRateLimitCloseToQuota(ustrd, sourceChannel, types.PACKET_SEND)
+
+	packetDataV2 := types.FungibleTokenPacketDataV2{
+		Tokens: []types.Token{
+			{Denom: types.Denom{Base: ustrd}, Amount: "1"},
+			{Denom: types.Denom{Base: ustrd}, Amount: "1"},
+		},
+	}
+	packetData, err := json.Marshal(packetDataV2)
+	s.Require().NoError(err)
+
+	packet := channeltypes.Packet{
+		SourcePort:         transferPort,
+		SourceChannel:      sourceChannel,
+		DestinationPort:    transferPort,
+		DestinationChannel: destinationChannel,
+		Data:               packetData,
+	}
+
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, packet)
+	s.Require().ErrorIs(err, types.ErrQuotaExceeded, "error type")
+	s.Require().ErrorContains(err, "Outflow exceeds quota", "error text")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, ustrd, sourceChannel)
+	s.Require().True(found)
+	s.Require().True(rateLimit.Flow.Outflow.Equal(sdkmath.NewInt(9)), "outflow should not have been updated")
+}
+
+// TestSendRateLimitedPacket_MalformedAmount confirms a packet with an unparseable amount
+// is rejected outright, rather than silently treated as a zero-amount transfer that would
+// never breach quota
+func (s *KeeperTestSuite) TestSendRateLimitedPacket_MalformedAmount() {
+	denom := ustrd
+	sourceChannel := channelOnStride
+	destinationChannel := channelOnHost
+
+	s.createRateLimitCloseToQuota(denom, sourceChannel, types.PACKET_SEND)
+
+	packetData, err := json.Marshal(transfertypes.FungibleTokenPacketData{Denom: denom, Amount: "not-a-number"})
+	s.Require().NoError(err)
+	packet := channeltypes.Packet{
+		SourcePort:         transferPort,
+		SourceChannel:      sourceChannel,
+		DestinationPort:    transferPort,
+		DestinationChannel: destinationChannel,
+		Data:               packetData,
+	}
+
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, packet)
+	s.Require().ErrorIs(err, types.ErrInvalidPacketData, "error type")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, sourceChannel)
+	s.Require().True(found)
+	s.Require().True(rateLimit.Flow.Outflow.Equal(sdkmath.NewInt(9)), "outflow should not have been updated")
+}
+
+// TestSendRateLimitedPacket_NegativeAmount confirms a packet with a negative amount is
+// rejected outright, rather than being subtracted from the recorded outflow and buying the
+// sender unlimited headroom for later packets
+func (s *KeeperTestSuite) TestSendRateLimitedPacket_NegativeAmount() {
+	denom := ustrd
+	sourceChannel := channelOnStride
+	destinationChannel := channelOnHost
+
+	s.createRateLimitCloseToQuota(denom, sourceChannel, types.PACKET_SEND)
+
+	packetData, err := json.Marshal(transfertypes.FungibleTokenPacketData{Denom: denom, Amount: "-100"})
+	s.Require().NoError(err)
+	packet := channeltypes.Packet{
+		SourcePort:         transferPort,
+		SourceChannel:      sourceChannel,
+		DestinationPort:    transferPort,
+		DestinationChannel: destinationChannel,
+		Data:               packetData,
+	}
+
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, packet)
+	s.Require().ErrorIs(err, types.ErrInvalidPacketData, "error type")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, sourceChannel)
+	s.Require().True(found)
+	s.Require().True(rateLimit.Flow.Outflow.Equal(sdkmath.NewInt(9)), "outflow should not have been updated")
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/x/ratelimit/types"
+)
+
+// GetParams returns the module's current parameters, falling back to the defaults if
+// none have been set yet
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	var params types.Params
+	if err := json.Unmarshal(bz, &params); err != nil {
+		panic(err)
+	}
+	return params
+}
+
+// SetParams stores the module's parameters
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz, err := json.Marshal(params)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.ParamsKey, bz)
+}
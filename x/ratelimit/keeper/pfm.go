@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/x/ratelimit/types"
+)
+
+// markPendingForward records that amount of denom (as resolved on this chain) arrived as
+// part of a PFM pass-through bound for (forwardPort, forwardChannel, forwardReceiver) - the
+// next hop's destination as named by the forward instruction - so that the corresponding
+// outgoing send leg - which re-sends the same denom and amount on to that next hop, but
+// whose own memo no longer carries the forward instruction that caused this hop - can
+// recognize it as transit volume rather than settled volume. Keying on the next hop's
+// port/channel/receiver, not just denom and amount, keeps an unrelated packet that happens
+// to move the same denom and amount in the same block from being misclassified as transit.
+// This is kept in the memory store rather than the persisted store since it's only ever
+// meant to bridge the receive and send legs of a single packet-forward relay, both of which
+// happen in the same block
+func (k Keeper) markPendingForward(ctx sdk.Context, denom string, amount sdkmath.Int, forwardPort, forwardChannel, forwardReceiver string) {
+	store := ctx.KVStore(k.memKey)
+	key := append(types.PendingForwardKeyPrefix, types.GetPendingForwardKey(denom, amount.String(), forwardPort, forwardChannel, forwardReceiver)...)
+
+	var count uint64
+	if bz := store.Get(key); bz != nil {
+		count = binary.BigEndian.Uint64(bz)
+	}
+	count++
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, count)
+	store.Set(key, bz)
+}
+
+// consumePendingForward checks whether amount of denom, about to be sent out via
+// (sendPort, sendChannel) to receiver, was just marked as an in-flight PFM pass-through by
+// markPendingForward, and if so, consumes one occurrence of it
+func (k Keeper) consumePendingForward(ctx sdk.Context, denom string, amount sdkmath.Int, sendPort, sendChannel, receiver string) bool {
+	store := ctx.KVStore(k.memKey)
+	key := append(types.PendingForwardKeyPrefix, types.GetPendingForwardKey(denom, amount.String(), sendPort, sendChannel, receiver)...)
+
+	bz := store.Get(key)
+	if bz == nil {
+		return false
+	}
+
+	count := binary.BigEndian.Uint64(bz)
+	if count <= 1 {
+		store.Delete(key)
+	} else {
+		count--
+		updated := make([]byte, 8)
+		binary.BigEndian.PutUint64(updated, count)
+		store.Set(key, updated)
+	}
+	return true
+}
@@ -0,0 +1,136 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+	transfertypes "github.com/cosmos/ibc-go/v5/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+
+	"github.com/Stride-Labs/stride/v9/x/ratelimit/types"
+)
+
+func (s *KeeperTestSuite) forwardedMemo() string {
+	metadata := types.PacketForwardMetadata{
+		Forward: &types.ForwardMetadata{
+			Receiver: "osmo1abc...",
+			Port:     transferPort,
+			Channel:  "channel-2",
+		},
+	}
+	bz, err := json.Marshal(metadata)
+	s.Require().NoError(err)
+	return string(bz)
+}
+
+// TestReceiveRateLimitedPacket_PFMForward confirms that a packet forwarded onward via
+// PFM does not accumulate against the Stride-native inflow quota
+func (s *KeeperTestSuite) TestReceiveRateLimitedPacket_PFMForward() {
+	sourceChannel := channelOnHost
+	destinationChannel := channelOnStride
+
+	rateLimitDenom := hashDenomTrace(fmt.Sprintf("%s/%s/%s", transferPort, destinationChannel, uosmo))
+	s.createRateLimitCloseToQuota(rateLimitDenom, destinationChannel, types.PACKET_RECV)
+
+	// This amount would breach quota if it were accumulated as settled inflow
+	packetData, err := json.Marshal(transfertypes.FungibleTokenPacketData{
+		Denom:  uosmo,
+		Amount: "5",
+		Memo:   s.forwardedMemo(),
+	})
+	s.Require().NoError(err)
+
+	packet := channeltypes.Packet{
+		SourcePort:         transferPort,
+		SourceChannel:      sourceChannel,
+		DestinationPort:    transferPort,
+		DestinationChannel: destinationChannel,
+		Data:               packetData,
+	}
+
+	err = s.App.RatelimitKeeper.ReceiveRateLimitedPacket(s.Ctx, packet)
+	s.Require().NoError(err, "forwarded packet should not be checked against the settled quota")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, rateLimitDenom, destinationChannel)
+	s.Require().True(found)
+	s.Require().True(rateLimit.Flow.Inflow.Equal(sdkmath.NewInt(9)), "settled inflow should be unaffected by a forwarded packet")
+}
+
+// TestReceiveRateLimitedPacket_PFMForward_TransitTracked confirms that, when transit
+// tracking is enabled, a forwarded packet is checked against a dedicated transit bucket
+// instead of being skipped or counted against settled volume
+func (s *KeeperTestSuite) TestReceiveRateLimitedPacket_PFMForward_TransitTracked() {
+	sourceChannel := channelOnHost
+	destinationChannel := channelOnStride
+
+	rateLimitDenom := hashDenomTrace(fmt.Sprintf("%s/%s/%s", transferPort, destinationChannel, uosmo))
+	s.App.RatelimitKeeper.SetParams(s.Ctx, types.Params{TrackTransitVolume: true})
+	s.createRateLimitCloseToQuota(rateLimitDenom, types.TransitChannelId(destinationChannel), types.PACKET_RECV)
+
+	packetData, err := json.Marshal(transfertypes.FungibleTokenPacketData{
+		Denom:  uosmo,
+		Amount: "5",
+		Memo:   s.forwardedMemo(),
+	})
+	s.Require().NoError(err)
+
+	packet := channeltypes.Packet{
+		SourcePort:         transferPort,
+		SourceChannel:      sourceChannel,
+		DestinationPort:    transferPort,
+		DestinationChannel: destinationChannel,
+		Data:               packetData,
+	}
+
+	err = s.App.RatelimitKeeper.ReceiveRateLimitedPacket(s.Ctx, packet)
+	s.Require().ErrorIs(err, types.ErrQuotaExceeded, "error type")
+	s.Require().ErrorContains(err, "Inflow exceeds quota", "error text")
+}
+
+// TestSendRateLimitedPacket_PFMForward confirms that the outgoing leg of a single-hop PFM
+// relay is recognized as transit volume rather than settled volume, even though by the time
+// it's sent back out its own memo no longer carries the forward instruction that caused it
+func (s *KeeperTestSuite) TestSendRateLimitedPacket_PFMForward() {
+	inboundChannel := channelOnHost
+	strideChannel := channelOnStride
+	outboundChannel := "channel-2"
+
+	// uosmo settles on neither leg - it's only ever transiting through Stride, where it's
+	// held as the voucher for transfer/<strideChannel>/uosmo on both legs
+	transitDenom := hashDenomTrace(fmt.Sprintf("%s/%s/%s", transferPort, strideChannel, uosmo))
+	s.createRateLimitCloseToQuota(transitDenom, strideChannel, types.PACKET_RECV)
+	s.createRateLimitCloseToQuota(transitDenom, outboundChannel, types.PACKET_SEND)
+
+	recvPacketData, err := json.Marshal(transfertypes.FungibleTokenPacketData{
+		Denom:  uosmo,
+		Amount: "5",
+		Memo:   s.forwardedMemo(),
+	})
+	s.Require().NoError(err)
+
+	recvPacket := channeltypes.Packet{
+		SourcePort:         transferPort,
+		SourceChannel:      inboundChannel,
+		DestinationPort:    transferPort,
+		DestinationChannel: strideChannel,
+		Data:               recvPacketData,
+	}
+
+	// Receiving the forwarded packet marks it as pending transit for the matching send leg
+	err = s.App.RatelimitKeeper.ReceiveRateLimitedPacket(s.Ctx, recvPacket)
+	s.Require().NoError(err, "forwarded packet should not be checked against the settled quota")
+
+	// PFM re-sends on to the next hop with a plain (non-forward) memo, since there are no
+	// further hops - so this leg can only be recognized as transit via the pending marker
+	sendPacketData, err := marshalTransferPacket("stride1relay...", "osmo1abc...", fmt.Sprintf("%s/%s/%s", transferPort, strideChannel, uosmo), "5")
+	s.Require().NoError(err)
+	sendPacket := makeSendPacket(outboundChannel, "channel-3", sendPacketData)
+
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, sendPacket)
+	s.Require().NoError(err, "relayed-on packet should not be checked against the settled quota")
+
+	rateLimit, found := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, transitDenom, outboundChannel)
+	s.Require().True(found)
+	s.Require().True(rateLimit.Flow.Outflow.Equal(sdkmath.NewInt(9)), "settled outflow should be unaffected by a relayed-on packet")
+}
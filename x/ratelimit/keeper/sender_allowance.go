@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/x/ratelimit/types"
+)
+
+// GetSenderAllowance fetches a sender allowance given the sender, channel-id and denom
+func (k Keeper) GetSenderAllowance(ctx sdk.Context, sender, channelId, denom string) (types.SenderAllowance, bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := append(types.SenderAllowanceKeyPrefix, types.GetSenderAllowanceKey(sender, channelId, denom)...)
+
+	bz := store.Get(key)
+	if bz == nil {
+		return types.SenderAllowance{}, false
+	}
+
+	var allowance types.SenderAllowance
+	if err := json.Unmarshal(bz, &allowance); err != nil {
+		panic(err)
+	}
+	return allowance, true
+}
+
+// SetSenderAllowance stores a sender allowance, keyed by its sender, channel-id and denom
+func (k Keeper) SetSenderAllowance(ctx sdk.Context, allowance types.SenderAllowance) {
+	store := ctx.KVStore(k.storeKey)
+	key := append(types.SenderAllowanceKeyPrefix, types.GetSenderAllowanceKey(allowance.Sender, allowance.ChannelId, allowance.Denom)...)
+
+	bz, err := json.Marshal(allowance)
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+// RemoveSenderAllowance deletes a sender allowance given the sender, channel-id and denom
+func (k Keeper) RemoveSenderAllowance(ctx sdk.Context, sender, channelId, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	key := append(types.SenderAllowanceKeyPrefix, types.GetSenderAllowanceKey(sender, channelId, denom)...)
+	store.Delete(key)
+}
+
+// evaluateSenderAllowance looks up the allowance for (sender, channelId, denom) and, if one
+// exists, checks that receiver is allow-listed and that amount doesn't exceed the
+// remaining spend limit. If no allowance exists for this sender, the packet isn't scoped
+// by one and this is a no-op. It does NOT write the debited allowance to the store - it
+// returns the updated copy for the caller to persist once every token in the packet is
+// known to pass, so that one token breaching quota can't leave an earlier token's debit
+// in the same packet persisted
+func (k Keeper) evaluateSenderAllowance(ctx sdk.Context, sender, channelId, denom, receiver string, amount sdkmath.Int) (*types.SenderAllowance, error) {
+	allowance, found := k.GetSenderAllowance(ctx, sender, channelId, denom)
+	if !found {
+		return nil, nil
+	}
+
+	if !allowance.AllowsReceiver(receiver) {
+		return nil, types.ErrReceiverNotAllowed.Wrapf("receiver %s is not allow-listed for sender %s", receiver, sender)
+	}
+
+	if amount.GT(allowance.SpendLimit) {
+		return nil, types.ErrSenderAllowanceExceeded.Wrapf("amount %s exceeds remaining allowance %s for sender %s", amount, allowance.SpendLimit, sender)
+	}
+
+	allowance.SpendLimit = allowance.SpendLimit.Sub(amount)
+	return &allowance, nil
+}
@@ -0,0 +1,78 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Stride-Labs/stride/v9/x/ratelimit/types"
+)
+
+func (s *KeeperTestSuite) TestDebitSenderAllowance() {
+	sender := "stride1sender..."
+	channelId := channelOnStride
+	denom := ustrd
+
+	s.App.RatelimitKeeper.SetSenderAllowance(s.Ctx, types.SenderAllowance{
+		Sender:     sender,
+		ChannelId:  channelId,
+		Denom:      denom,
+		SpendLimit: sdkmath.NewInt(10),
+		AllowList:  []string{"osmo1receiver..."},
+	})
+
+	packetData, err := marshalTransferPacket(sender, "osmo1receiver...", denom, "4")
+	s.Require().NoError(err)
+	packet := makeSendPacket(channelId, channelOnHost, packetData)
+
+	s.createRateLimitCloseToQuota(denom, channelId, types.PACKET_SEND)
+	// Bump the quota up so only the allowance is the binding constraint
+	rateLimit, _ := s.App.RatelimitKeeper.GetRateLimit(s.Ctx, denom, channelId)
+	rateLimit.Quota.MaxPercentSend = sdkmath.NewInt(100)
+	s.App.RatelimitKeeper.SetRateLimit(s.Ctx, rateLimit)
+
+	s.Require().NoError(s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, packet))
+
+	allowance, found := s.App.RatelimitKeeper.GetSenderAllowance(s.Ctx, sender, channelId, denom)
+	s.Require().True(found)
+	s.Require().Equal(sdkmath.NewInt(6), allowance.SpendLimit, "allowance should be debited by the packet amount")
+}
+
+func (s *KeeperTestSuite) TestDebitSenderAllowance_ExceedsLimit() {
+	sender := "stride1sender..."
+	channelId := channelOnStride
+	denom := ustrd
+
+	s.App.RatelimitKeeper.SetSenderAllowance(s.Ctx, types.SenderAllowance{
+		Sender:     sender,
+		ChannelId:  channelId,
+		Denom:      denom,
+		SpendLimit: sdkmath.NewInt(3),
+	})
+
+	packetData, err := marshalTransferPacket(sender, "osmo1receiver...", denom, "4")
+	s.Require().NoError(err)
+	packet := makeSendPacket(channelId, channelOnHost, packetData)
+
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, packet)
+	s.Require().ErrorIs(err, types.ErrSenderAllowanceExceeded)
+}
+
+func (s *KeeperTestSuite) TestDebitSenderAllowance_ReceiverNotAllowed() {
+	sender := "stride1sender..."
+	channelId := channelOnStride
+	denom := ustrd
+
+	s.App.RatelimitKeeper.SetSenderAllowance(s.Ctx, types.SenderAllowance{
+		Sender:     sender,
+		ChannelId:  channelId,
+		Denom:      denom,
+		SpendLimit: sdkmath.NewInt(10),
+		AllowList:  []string{"osmo1allowed..."},
+	})
+
+	packetData, err := marshalTransferPacket(sender, "osmo1other...", denom, "1")
+	s.Require().NoError(err)
+	packet := makeSendPacket(channelId, channelOnHost, packetData)
+
+	err = s.App.RatelimitKeeper.SendRateLimitedPacket(s.Ctx, packet)
+	s.Require().ErrorIs(err, types.ErrReceiverNotAllowed)
+}
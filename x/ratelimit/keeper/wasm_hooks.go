@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/x/ratelimit/types"
+)
+
+// wasmHookGasLimit bounds the gas a hook contract can burn processing a sudo message, so a
+// misbehaving contract can't stall OnRecvPacket for the rest of the chain
+const wasmHookGasLimit = storetypes.Gas(500_000)
+
+// SendRateLimitExceededHook fires a sudo message to the registered hook contract when a
+// packet is rejected for breaching denom's rate limit on channelId. It's best-effort: any
+// error or out-of-gas panic from the contract is logged and swallowed rather than
+// propagated, since a hook failure shouldn't block the underlying packet rejection
+func (k Keeper) SendRateLimitExceededHook(ctx sdk.Context, direction types.PacketDirection, rateLimit types.RateLimit, amount sdkmath.Int) {
+	directionName := "send"
+	if direction == types.PACKET_RECV {
+		directionName = "recv"
+	}
+
+	msg := types.WasmHookMessage{
+		RateLimitExceeded: &types.RateLimitExceededMsg{
+			Denom:     rateLimit.Path.Denom,
+			ChannelId: rateLimit.Path.ChannelId,
+			Direction: directionName,
+			Amount:    amount,
+			Quota: types.RateLimitedFlow{
+				Inflow:       rateLimit.Flow.Inflow,
+				Outflow:      rateLimit.Flow.Outflow,
+				ChannelValue: rateLimit.Flow.ChannelValue,
+			},
+		},
+	}
+	k.sendWasmHook(ctx, msg)
+}
+
+// SendEpochResetHook fires a sudo message to the registered hook contract when a rate
+// limit's flow window rolls over. Called by ResetRateLimitFlow once it has reset the flow
+func (k Keeper) SendEpochResetHook(ctx sdk.Context, denom, channelId string) {
+	msg := types.WasmHookMessage{
+		EpochReset: &types.EpochResetMsg{
+			Denom:     denom,
+			ChannelId: channelId,
+		},
+	}
+	k.sendWasmHook(ctx, msg)
+}
+
+// sendWasmHook marshals msg and delivers it via sudo to the configured hook contract,
+// metering the hook on its own gas meter capped at wasmHookGasLimit (the gas it used is then
+// charged to the caller's meter) and recovering any panic from the contract call itself
+func (k Keeper) sendWasmHook(ctx sdk.Context, msg types.WasmHookMessage) {
+	params := k.GetParams(ctx)
+	if params.HookContractAddress == "" || k.WasmKeeper == nil {
+		return
+	}
+
+	contractAddress, err := sdk.AccAddressFromBech32(params.HookContractAddress)
+	if err != nil {
+		k.Logger(ctx).Error("invalid ratelimit hook contract address", "address", params.HookContractAddress, "error", err)
+		return
+	}
+
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		k.Logger(ctx).Error("failed to marshal ratelimit wasm hook message", "error", err)
+		return
+	}
+
+	func() {
+		hookGasMeter := sdk.NewGasMeter(wasmHookGasLimit)
+		hookCtx := ctx.WithGasMeter(hookGasMeter)
+		defer func() {
+			// Charge whatever the hook consumed (capped at wasmHookGasLimit) back to the
+			// parent context's meter, so a hook can't give its triggering tx or block free
+			// computation by repeatedly tripping a rate limit
+			ctx.GasMeter().ConsumeGas(hookGasMeter.GasConsumedToLimit(), "ratelimit wasm hook")
+
+			if r := recover(); r != nil {
+				k.Logger(ctx).Error("ratelimit wasm hook panicked", "contract", params.HookContractAddress, "recover", r)
+			}
+		}()
+
+		if _, err := k.WasmKeeper.Sudo(hookCtx, contractAddress, bz); err != nil {
+			k.Logger(ctx).Error("ratelimit wasm hook returned an error", "contract", params.HookContractAddress, "error", err)
+		}
+	}()
+}
@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Stride-Labs/stride/v9/x/ratelimit/types"
+)
+
+type mockWasmKeeper struct {
+	sudoCalls   [][]byte
+	shouldFail  bool
+	shouldPanic bool
+}
+
+func (m *mockWasmKeeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	if m.shouldPanic {
+		panic("out of gas")
+	}
+	if m.shouldFail {
+		return nil, errors.New("mock failure")
+	}
+	m.sudoCalls = append(m.sudoCalls, msg)
+	return nil, nil
+}
+
+// hookContractAddress returns a well-formed bech32 address for the hook contract, since
+// sendWasmHook skips the sudo call for an address that doesn't parse
+func hookContractAddress() string {
+	return sdk.AccAddress([]byte("hook_contract_______")).String()
+}
+
+func (s *KeeperTestSuite) TestSendRateLimitExceededHook() {
+	mock := &mockWasmKeeper{}
+	k := s.App.RatelimitKeeper
+	k.WasmKeeper = mock
+	k.SetParams(s.Ctx, types.Params{HookContractAddress: hookContractAddress()})
+
+	rateLimit := types.RateLimit{
+		Path:  &types.Path{Denom: ustrd, ChannelId: channelOnStride},
+		Quota: &types.Quota{MaxPercentSend: sdkmath.NewInt(10), MaxPercentRecv: sdkmath.NewInt(10)},
+		Flow:  &types.Flow{Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.NewInt(9), ChannelValue: sdkmath.NewInt(100)},
+	}
+
+	k.SendRateLimitExceededHook(s.Ctx, types.PACKET_SEND, rateLimit, sdkmath.NewInt(5))
+
+	s.Require().Len(mock.sudoCalls, 1)
+
+	var msg types.WasmHookMessage
+	s.Require().NoError(json.Unmarshal(mock.sudoCalls[0], &msg))
+	s.Require().NotNil(msg.RateLimitExceeded)
+	s.Require().Equal(ustrd, msg.RateLimitExceeded.Denom)
+	s.Require().Equal("send", msg.RateLimitExceeded.Direction)
+}
+
+// TestSendRateLimitExceededHook_ContractPanics confirms a misbehaving hook contract
+// can't stall the caller - a panicking Sudo call is recovered rather than propagated
+func (s *KeeperTestSuite) TestSendRateLimitExceededHook_ContractPanics() {
+	mock := &mockWasmKeeper{shouldPanic: true}
+	k := s.App.RatelimitKeeper
+	k.WasmKeeper = mock
+	k.SetParams(s.Ctx, types.Params{HookContractAddress: hookContractAddress()})
+
+	rateLimit := types.RateLimit{
+		Path:  &types.Path{Denom: ustrd, ChannelId: channelOnStride},
+		Quota: &types.Quota{MaxPercentSend: sdkmath.NewInt(10), MaxPercentRecv: sdkmath.NewInt(10)},
+		Flow:  &types.Flow{Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.NewInt(9), ChannelValue: sdkmath.NewInt(100)},
+	}
+
+	s.Require().NotPanics(func() {
+		k.SendRateLimitExceededHook(s.Ctx, types.PACKET_SEND, rateLimit, sdkmath.NewInt(5))
+	})
+}
+
+// TestResetRateLimitFlow confirms that resetting a rate limit's flow zeroes it out and
+// fires the epoch-reset hook
+func (s *KeeperTestSuite) TestResetRateLimitFlow() {
+	mock := &mockWasmKeeper{}
+	k := s.App.RatelimitKeeper
+	k.WasmKeeper = mock
+	k.SetParams(s.Ctx, types.Params{HookContractAddress: hookContractAddress()})
+
+	k.SetRateLimit(s.Ctx, types.RateLimit{
+		Path:  &types.Path{Denom: ustrd, ChannelId: channelOnStride},
+		Quota: &types.Quota{MaxPercentSend: sdkmath.NewInt(10), MaxPercentRecv: sdkmath.NewInt(10)},
+		Flow:  &types.Flow{Inflow: sdkmath.NewInt(7), Outflow: sdkmath.NewInt(9), ChannelValue: sdkmath.NewInt(100)},
+	})
+
+	s.Require().NoError(k.ResetRateLimitFlow(s.Ctx, ustrd, channelOnStride))
+
+	rateLimit, found := k.GetRateLimit(s.Ctx, ustrd, channelOnStride)
+	s.Require().True(found)
+	s.Require().True(rateLimit.Flow.Inflow.IsZero(), "inflow should be reset")
+	s.Require().True(rateLimit.Flow.Outflow.IsZero(), "outflow should be reset")
+
+	s.Require().Len(mock.sudoCalls, 1)
+	var msg types.WasmHookMessage
+	s.Require().NoError(json.Unmarshal(mock.sudoCalls[0], &msg))
+	s.Require().NotNil(msg.EpochReset)
+	s.Require().Equal(ustrd, msg.EpochReset.Denom)
+}
+
+// TestResetRateLimitFlow_NotFound confirms resetting a rate limit that doesn't exist errors
+func (s *KeeperTestSuite) TestResetRateLimitFlow_NotFound() {
+	k := s.App.RatelimitKeeper
+	err := k.ResetRateLimitFlow(s.Ctx, ustrd, channelOnStride)
+	s.Require().ErrorIs(err, types.ErrRateLimitNotFound)
+}
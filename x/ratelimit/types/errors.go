@@ -0,0 +1,16 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+)
+
+var (
+	ErrRateLimitNotFound       = sdkerrors.Register(ModuleName, 2, "rate limit not found")
+	ErrRateLimitAlreadyExists  = sdkerrors.Register(ModuleName, 3, "rate limit already exists")
+	ErrQuotaExceeded           = sdkerrors.Register(ModuleName, 4, "quota exceeded")
+	ErrInvalidPacketData       = sdkerrors.Register(ModuleName, 5, "invalid packet data")
+	ErrSenderAllowanceNotFound = sdkerrors.Register(ModuleName, 6, "sender allowance not found")
+	ErrSenderAllowanceExceeded = sdkerrors.Register(ModuleName, 7, "sender allowance exceeded")
+	ErrReceiverNotAllowed      = sdkerrors.Register(ModuleName, 8, "receiver is not on the sender allowance's allow-list")
+	ErrInvalidAuthority        = sdkerrors.Register(ModuleName, 9, "invalid authority")
+)
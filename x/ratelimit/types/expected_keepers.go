@@ -0,0 +1,18 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v5/modules/core/04-channel/types"
+)
+
+// ChannelKeeper defines the expected IBC channel keeper used to look up channel
+// and connection information (e.g. the negotiated app version on a channel)
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, portID, channelID string) (channel channeltypes.Channel, found bool)
+}
+
+// WasmKeeper defines the expected CosmWasm keeper used to deliver sudo hook messages to
+// a registered contract on quota-exceed and quota-reset events
+type WasmKeeper interface {
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
@@ -0,0 +1,56 @@
+package types
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "ratelimit"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the module
+	RouterKey = ModuleName
+
+	// QuerierRoute defines the module's query routing key
+	QuerierRoute = ModuleName
+)
+
+var (
+	RateLimitKeyPrefix       = []byte{0x01}
+	ParamsKey                = []byte{0x02}
+	SenderAllowanceKeyPrefix = []byte{0x03}
+
+	// PendingForwardKeyPrefix is a memory-store (not persisted across blocks) prefix used
+	// to correlate a PFM-forwarded packet's receive leg with its outgoing send leg
+	PendingForwardKeyPrefix = []byte{0x04}
+)
+
+// GetPendingForwardKey returns the memory-store key used to correlate a PFM pass-through
+// packet's receive leg with its outgoing send leg: the denom (as resolved on this chain)
+// and amount common to both legs, plus the next hop's port, channel and receiver, so an
+// unrelated packet moving the same denom and amount in the same block isn't mistaken for
+// the same relay
+func GetPendingForwardKey(denom, amount, port, channel, receiver string) []byte {
+	return []byte(denom + "|" + amount + "|" + port + "|" + channel + "|" + receiver)
+}
+
+// GetRateLimitItemKey returns the store key for a rate limit, keyed by denom and channel-id
+func GetRateLimitItemKey(denom string, channelId string) []byte {
+	return []byte(denom + "|" + channelId)
+}
+
+// transitChannelSuffix marks a rate limit as tracking PFM pass-through ("transit")
+// volume for a denom, rather than volume that settles on Stride
+const transitChannelSuffix = ":transit"
+
+// TransitChannelId returns the synthetic channel-id used to key the transit-volume
+// rate limit for a given channel, keeping it distinct from that channel's settled-volume
+// rate limit
+func TransitChannelId(channelId string) string {
+	return channelId + transitChannelSuffix
+}
+
+// GetSenderAllowanceKey returns the store key for a sender allowance, keyed by the
+// sender's bech32 address, the channel-id, and the denom
+func GetSenderAllowanceKey(sender, channelId, denom string) []byte {
+	return []byte(sender + "|" + channelId + "|" + denom)
+}
@@ -0,0 +1,142 @@
+package types
+
+import (
+	sdkerrors "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	TypeMsgGrantSenderAllowance  = "grant_sender_allowance"
+	TypeMsgRevokeSenderAllowance = "revoke_sender_allowance"
+)
+
+var (
+	_ sdk.Msg = &MsgGrantSenderAllowance{}
+	_ sdk.Msg = &MsgRevokeSenderAllowance{}
+)
+
+// MsgGrantSenderAllowanceResponse is the response to a MsgGrantSenderAllowance
+type MsgGrantSenderAllowanceResponse struct{}
+
+// MsgRevokeSenderAllowanceResponse is the response to a MsgRevokeSenderAllowance
+type MsgRevokeSenderAllowanceResponse struct{}
+
+// MsgGrantSenderAllowance grants a sender a spend limit, scoped to a channel and denom,
+// optionally restricted to an allow-list of receivers
+type MsgGrantSenderAllowance struct {
+	Authority  string
+	Sender     string
+	ChannelId  string
+	Denom      string
+	SpendLimit sdkmath.Int
+	AllowList  []string
+}
+
+func NewMsgGrantSenderAllowance(authority, sender, channelId, denom string, spendLimit sdkmath.Int, allowList []string) *MsgGrantSenderAllowance {
+	return &MsgGrantSenderAllowance{
+		Authority:  authority,
+		Sender:     sender,
+		ChannelId:  channelId,
+		Denom:      denom,
+		SpendLimit: spendLimit,
+		AllowList:  allowList,
+	}
+}
+
+func (msg MsgGrantSenderAllowance) Route() string {
+	return RouterKey
+}
+
+func (msg MsgGrantSenderAllowance) Type() string {
+	return TypeMsgGrantSenderAllowance
+}
+
+func (msg MsgGrantSenderAllowance) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg MsgGrantSenderAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgGrantSenderAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(err, "invalid authority address (%s)", msg.Authority)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(err, "invalid sender address (%s)", msg.Sender)
+	}
+	if msg.ChannelId == "" {
+		return sdkerrors.Wrap(ErrInvalidPacketData, "channel-id cannot be empty")
+	}
+	if msg.Denom == "" {
+		return sdkerrors.Wrap(ErrInvalidPacketData, "denom cannot be empty")
+	}
+	if msg.SpendLimit.IsNil() || msg.SpendLimit.IsNegative() {
+		return sdkerrors.Wrap(ErrInvalidPacketData, "spend limit must be a non-negative integer")
+	}
+	for _, receiver := range msg.AllowList {
+		if receiver == "" {
+			return sdkerrors.Wrap(ErrInvalidPacketData, "allow-list entries cannot be empty")
+		}
+	}
+	return nil
+}
+
+// MsgRevokeSenderAllowance revokes a previously granted sender allowance
+type MsgRevokeSenderAllowance struct {
+	Authority string
+	Sender    string
+	ChannelId string
+	Denom     string
+}
+
+func NewMsgRevokeSenderAllowance(authority, sender, channelId, denom string) *MsgRevokeSenderAllowance {
+	return &MsgRevokeSenderAllowance{
+		Authority: authority,
+		Sender:    sender,
+		ChannelId: channelId,
+		Denom:     denom,
+	}
+}
+
+func (msg MsgRevokeSenderAllowance) Route() string {
+	return RouterKey
+}
+
+func (msg MsgRevokeSenderAllowance) Type() string {
+	return TypeMsgRevokeSenderAllowance
+}
+
+func (msg MsgRevokeSenderAllowance) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg MsgRevokeSenderAllowance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgRevokeSenderAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(err, "invalid authority address (%s)", msg.Authority)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return sdkerrors.Wrapf(err, "invalid sender address (%s)", msg.Sender)
+	}
+	if msg.ChannelId == "" {
+		return sdkerrors.Wrap(ErrInvalidPacketData, "channel-id cannot be empty")
+	}
+	if msg.Denom == "" {
+		return sdkerrors.Wrap(ErrInvalidPacketData, "denom cannot be empty")
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+const (
+	ICS20V1 = "ics20-1"
+	ICS20V2 = "ics20-2"
+)
+
+// PacketData is a version-agnostic view over a transfer packet's data, letting the
+// rate-limit logic iterate over its token(s) uniformly regardless of whether the
+// underlying packet is a v1 (single-coin) or v2 (multi-coin) FungibleTokenPacketData
+type PacketData interface {
+	GetDenoms() []string
+	GetAmounts() ([]sdkmath.Int, error)
+	GetSender() string
+	GetReceiver() string
+	GetMemo() string
+}
+
+// packetDataV1 adapts a v1 FungibleTokenPacketData to the PacketData interface
+type packetDataV1 struct {
+	Denom    string
+	Amount   string
+	Sender   string
+	Receiver string
+	Memo     string
+}
+
+func NewPacketDataV1(denom, amount, sender, receiver, memo string) PacketData {
+	return packetDataV1{Denom: denom, Amount: amount, Sender: sender, Receiver: receiver, Memo: memo}
+}
+
+func (p packetDataV1) GetDenoms() []string { return []string{p.Denom} }
+
+func (p packetDataV1) GetAmounts() ([]sdkmath.Int, error) {
+	amount, ok := sdkmath.NewIntFromString(p.Amount)
+	if !ok || amount.IsNegative() {
+		return nil, ErrInvalidPacketData.Wrapf("invalid amount: %s", p.Amount)
+	}
+	return []sdkmath.Int{amount}, nil
+}
+
+func (p packetDataV1) GetSender() string   { return p.Sender }
+func (p packetDataV1) GetReceiver() string { return p.Receiver }
+func (p packetDataV1) GetMemo() string     { return p.Memo }
+
+// packetDataV2 adapts a v2 FungibleTokenPacketDataV2 to the PacketData interface
+type packetDataV2 struct {
+	FungibleTokenPacketDataV2
+}
+
+func NewPacketDataV2(data FungibleTokenPacketDataV2) PacketData {
+	return packetDataV2{data}
+}
+
+func (p packetDataV2) GetDenoms() []string {
+	denoms := make([]string, len(p.Tokens))
+	for i, token := range p.Tokens {
+		denoms[i] = token.Denom.TracePath()
+	}
+	return denoms
+}
+
+func (p packetDataV2) GetAmounts() ([]sdkmath.Int, error) {
+	amounts := make([]sdkmath.Int, len(p.Tokens))
+	for i, token := range p.Tokens {
+		amount, ok := sdkmath.NewIntFromString(token.Amount)
+		if !ok || amount.IsNegative() {
+			return nil, ErrInvalidPacketData.Wrapf("invalid amount: %s", token.Amount)
+		}
+		amounts[i] = amount
+	}
+	return amounts, nil
+}
+
+func (p packetDataV2) GetSender() string   { return p.Sender }
+func (p packetDataV2) GetReceiver() string { return p.Receiver }
+func (p packetDataV2) GetMemo() string     { return p.Memo }
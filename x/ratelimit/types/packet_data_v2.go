@@ -0,0 +1,39 @@
+package types
+
+import "strings"
+
+// FungibleTokenPacketDataV2 mirrors the ICS20-v2 packet data shape (multiple tokens per
+// packet, each carrying its own denom trace) ahead of the ibc-go dependency bump that will
+// make transfertypes.FungibleTokenPacketDataV2 available directly. Once that bump lands,
+// this type should be dropped in favor of the upstream one.
+type FungibleTokenPacketDataV2 struct {
+	Tokens   []Token `json:"tokens"`
+	Sender   string  `json:"sender"`
+	Receiver string  `json:"receiver"`
+	Memo     string  `json:"memo,omitempty"`
+}
+
+// Token is a single fungible token transferred as part of a v2 packet
+type Token struct {
+	Denom  Denom  `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+// Denom is the v2 equivalent of a v1 denom trace: a base denom plus the list of
+// port/channel hops it has transited, stored as separate elements rather than
+// pre-joined into a single string
+type Denom struct {
+	Base  string   `json:"base"`
+	Trace []string `json:"trace,omitempty"`
+}
+
+// TracePath reconstructs the slash-joined "port/channel/.../base" trace path used by a
+// v1 FungibleTokenPacketData.Denom, by joining the hops in Trace with the base denom. Note
+// this is the raw trace path, not the hashed on-chain denom - callers still need to run it
+// through transfertypes.ParseDenomTrace(...).IBCDenom() to get that
+func (d Denom) TracePath() string {
+	if len(d.Trace) == 0 {
+		return d.Base
+	}
+	return strings.Join(d.Trace, "/") + "/" + d.Base
+}
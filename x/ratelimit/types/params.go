@@ -0,0 +1,20 @@
+package types
+
+// Params defines the ratelimit module's governance-adjustable settings
+type Params struct {
+	// TrackTransitVolume, when enabled, rate-limits PFM pass-through volume under a
+	// dedicated "transit" bucket (per denom and channel) rather than netting it out entirely against
+	// the Stride-native inflow/outflow quotas
+	TrackTransitVolume bool
+
+	// HookContractAddress, if set, is a CosmWasm contract that's sent a sudo message on
+	// quota-exceed and quota-reset events, so operators can wire in custom pause/alert logic
+	HookContractAddress string
+}
+
+func DefaultParams() Params {
+	return Params{
+		TrackTransitVolume:  false,
+		HookContractAddress: "",
+	}
+}
@@ -0,0 +1,36 @@
+package types
+
+import "encoding/json"
+
+// PacketForwardMetadata is the memo shape used by packet-forward-middleware (PFM) to
+// instruct an intermediate chain to re-forward a transfer on to a further hop, rather
+// than settle the funds locally
+type PacketForwardMetadata struct {
+	Forward *ForwardMetadata `json:"forward"`
+}
+
+// ForwardMetadata names the next hop a PFM-forwarded transfer should continue on to
+type ForwardMetadata struct {
+	Receiver string `json:"receiver"`
+	Port     string `json:"port"`
+	Channel  string `json:"channel"`
+}
+
+// ParsePacketForwardMemo checks whether a packet's memo contains a PFM forward
+// instruction, returning the forward metadata if so. A memo that isn't JSON, or that's
+// JSON without a "forward" key, is simply not a forwarded packet - not an error
+func ParsePacketForwardMemo(memo string) (forward *ForwardMetadata, isForwarded bool) {
+	if memo == "" {
+		return nil, false
+	}
+
+	var metadata PacketForwardMetadata
+	if err := json.Unmarshal([]byte(memo), &metadata); err != nil {
+		return nil, false
+	}
+	if metadata.Forward == nil {
+		return nil, false
+	}
+
+	return metadata.Forward, true
+}
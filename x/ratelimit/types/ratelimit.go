@@ -0,0 +1,77 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// PacketDirection indicates whether a packet is being sent out of Stride or received into Stride
+type PacketDirection int
+
+const (
+	PACKET_SEND PacketDirection = iota
+	PACKET_RECV
+)
+
+// Path identifies a rate limit by the denom (as it appears on Stride) and the
+// channel-id over which the denom flows (the source channel for a send, the
+// destination channel for a receive)
+type Path struct {
+	Denom     string
+	ChannelId string
+}
+
+// Quota is the threshold, expressed as a percent of the channel value, that inflows
+// or outflows are not allowed to exceed within a single rate limit window
+type Quota struct {
+	MaxPercentSend sdkmath.Int
+	MaxPercentRecv sdkmath.Int
+	DurationHours  uint64
+}
+
+// Flow tracks the cumulative inflow and outflow for a rate limit's window, along
+// with the channel value that was snapshotted at the start of the window
+type Flow struct {
+	Inflow       sdkmath.Int
+	Outflow      sdkmath.Int
+	ChannelValue sdkmath.Int
+}
+
+// RateLimit is the top level object stored for each (denom, channel-id) pair that
+// has an active rate limit
+type RateLimit struct {
+	Path  *Path
+	Quota *Quota
+	Flow  *Flow
+}
+
+// CheckExceedsQuota returns an error if adding amount to the flow in the given
+// direction would breach the quota for this rate limit
+func (r RateLimit) CheckExceedsQuota(direction PacketDirection, amount sdkmath.Int) error {
+	if r.Flow.ChannelValue.IsZero() {
+		return nil
+	}
+
+	switch direction {
+	case PACKET_SEND:
+		threshold := r.Quota.MaxPercentSend.Mul(r.Flow.ChannelValue).Quo(sdkmath.NewInt(100))
+		if r.Flow.Outflow.Add(amount).GT(threshold) {
+			return ErrQuotaExceeded.Wrapf("Outflow exceeds quota for denom %s on channel %s", r.Path.Denom, r.Path.ChannelId)
+		}
+	case PACKET_RECV:
+		threshold := r.Quota.MaxPercentRecv.Mul(r.Flow.ChannelValue).Quo(sdkmath.NewInt(100))
+		if r.Flow.Inflow.Add(amount).GT(threshold) {
+			return ErrQuotaExceeded.Wrapf("Inflow exceeds quota for denom %s on channel %s", r.Path.Denom, r.Path.ChannelId)
+		}
+	}
+	return nil
+}
+
+// UpdateFlow adds amount to the inflow or outflow, depending on the packet direction
+func (r *RateLimit) UpdateFlow(direction PacketDirection, amount sdkmath.Int) {
+	switch direction {
+	case PACKET_SEND:
+		r.Flow.Outflow = r.Flow.Outflow.Add(amount)
+	case PACKET_RECV:
+		r.Flow.Inflow = r.Flow.Inflow.Add(amount)
+	}
+}
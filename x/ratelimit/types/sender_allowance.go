@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+)
+
+// SenderAllowance is a per-sender, per-channel, per-denom spend limit, analogous to an
+// ICS-20 TransferAuthorization grant, but enforced by the ratelimit module rather than
+// x/authz. It lets governance (or an ICA-controlled account) budget a tightly scoped
+// transfer allowance for a sender on top of the channel-wide quota
+type SenderAllowance struct {
+	Sender     string
+	ChannelId  string
+	Denom      string
+	SpendLimit sdkmath.Int
+	AllowList  []string // if empty, any receiver is allowed
+}
+
+// AllowsReceiver returns whether receiver is permitted by the allowance's AllowList. An
+// empty AllowList permits any receiver
+func (a SenderAllowance) AllowsReceiver(receiver string) bool {
+	if len(a.AllowList) == 0 {
+		return true
+	}
+	for _, allowed := range a.AllowList {
+		if allowed == receiver {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/gogo/protobuf/proto"
+)
+
+// This file stands in for the protoc-gen-gocosmos output that would normally back the
+// module's Msg service (generated from a proto/stride/ratelimit/tx.proto definition). It
+// hand-implements the minimal gogoproto.Message surface - Reset/String/ProtoMessage - that
+// sdk.Msg and the interface registry require, so the module compiles without a protoc run.
+// Each type also registers a synthetic gogoproto message name below, mirroring what protoc
+// would normally generate, since the interface registry resolves implementations by that
+// name and panics on a collision if two distinct types both resolve to the same (empty)
+// name. If/when this module's protos are regenerated, these four types and this file should
+// be replaced by the generated tx.pb.go.
+
+func (m *MsgGrantSenderAllowance) Reset()         { *m = MsgGrantSenderAllowance{} }
+func (m *MsgGrantSenderAllowance) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgGrantSenderAllowance) ProtoMessage()    {}
+
+func (m *MsgGrantSenderAllowanceResponse) Reset()         { *m = MsgGrantSenderAllowanceResponse{} }
+func (m *MsgGrantSenderAllowanceResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgGrantSenderAllowanceResponse) ProtoMessage()    {}
+
+func (m *MsgRevokeSenderAllowance) Reset()         { *m = MsgRevokeSenderAllowance{} }
+func (m *MsgRevokeSenderAllowance) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgRevokeSenderAllowance) ProtoMessage()    {}
+
+func (m *MsgRevokeSenderAllowanceResponse) Reset()         { *m = MsgRevokeSenderAllowanceResponse{} }
+func (m *MsgRevokeSenderAllowanceResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*MsgRevokeSenderAllowanceResponse) ProtoMessage()    {}
+
+func init() {
+	proto.RegisterType((*MsgGrantSenderAllowance)(nil), "stride.ratelimit.MsgGrantSenderAllowance")
+	proto.RegisterType((*MsgGrantSenderAllowanceResponse)(nil), "stride.ratelimit.MsgGrantSenderAllowanceResponse")
+	proto.RegisterType((*MsgRevokeSenderAllowance)(nil), "stride.ratelimit.MsgRevokeSenderAllowance")
+	proto.RegisterType((*MsgRevokeSenderAllowanceResponse)(nil), "stride.ratelimit.MsgRevokeSenderAllowanceResponse")
+}
@@ -0,0 +1,33 @@
+package types
+
+import sdkmath "cosmossdk.io/math"
+
+// WasmHookMessage is the sudo message envelope sent to the registered hook contract. Exactly
+// one field is populated per message, matching the "enum"-style JSON schema CosmWasm
+// contracts expect for sudo dispatch
+type WasmHookMessage struct {
+	RateLimitExceeded *RateLimitExceededMsg `json:"rate_limit_exceeded,omitempty"`
+	EpochReset        *EpochResetMsg        `json:"epoch_reset,omitempty"`
+}
+
+// RateLimitExceededMsg is fired when a packet is rejected for breaching a rate limit's quota
+type RateLimitExceededMsg struct {
+	Denom     string          `json:"denom"`
+	ChannelId string          `json:"channel_id"`
+	Direction string          `json:"direction"`
+	Amount    sdkmath.Int     `json:"amount"`
+	Quota     RateLimitedFlow `json:"flow"`
+}
+
+// EpochResetMsg is fired when a rate limit's flow window rolls over
+type EpochResetMsg struct {
+	Denom     string `json:"denom"`
+	ChannelId string `json:"channel_id"`
+}
+
+// RateLimitedFlow is a snapshot of a rate limit's flow at the time a hook fires
+type RateLimitedFlow struct {
+	Inflow       sdkmath.Int `json:"inflow"`
+	Outflow      sdkmath.Int `json:"outflow"`
+	ChannelValue sdkmath.Int `json:"channel_value"`
+}